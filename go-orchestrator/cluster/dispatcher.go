@@ -0,0 +1,146 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/orchestrator"
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/simclient"
+)
+
+// Dispatcher fans a sweep's RunConfigs out across registered workers,
+// re-queueing a trial on a different worker if its assigned one fails.
+type Dispatcher struct {
+	Registry   *Registry
+	Scheduler  Scheduler
+	DataDir    string
+	RustDir    string
+	MaxRetries int
+
+	// Jobs bounds how many trials DispatchAll runs at once. Zero (the
+	// default) fans out to the registered workers' combined
+	// AvailableThreads at dispatch time, since that's the cluster's actual
+	// capacity rather than an arbitrary local number.
+	Jobs int
+
+	// runTrial executes cfg against worker. It defaults to the real
+	// gRPC dial-and-run path (set by NewDispatcher); tests substitute a
+	// fake here to exercise DispatchAll/dispatchOne's retry and
+	// concurrency behavior without a live worker.
+	runTrial func(ctx context.Context, worker *WorkerNode, cfg orchestrator.RunConfig) (orchestrator.RunResult, error)
+}
+
+// NewDispatcher builds a Dispatcher with sane defaults (up to 2 retries per
+// trial before giving up; concurrency bounded by registered worker
+// capacity). rustDir is the orchestrator-local rust-core checkout used to
+// stamp manifests with the revision that was deployed to the workers.
+func NewDispatcher(registry *Registry, scheduler Scheduler, dataDir, rustDir string) *Dispatcher {
+	d := &Dispatcher{Registry: registry, Scheduler: scheduler, DataDir: dataDir, RustDir: rustDir, MaxRetries: 2}
+	d.runTrial = d.runOn
+	return d
+}
+
+// Result pairs a dispatched RunConfig with its outcome and which worker
+// ultimately ran it.
+type Result struct {
+	Config   orchestrator.RunConfig
+	WorkerID string
+	Result   orchestrator.RunResult
+	Err      error
+}
+
+// DispatchAll runs every config across the registered workers, at most
+// d.Jobs at a time, retrying each on a different worker up to
+// d.MaxRetries times if its assigned worker fails.
+func (d *Dispatcher) DispatchAll(ctx context.Context, configs []orchestrator.RunConfig) []Result {
+	jobs := d.Jobs
+	if jobs < 1 {
+		jobs = totalCapacity(d.Registry.Healthy())
+	}
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	sem := make(chan struct{}, jobs)
+	results := make([]Result, len(configs))
+	var wg sync.WaitGroup
+
+	for i, cfg := range configs {
+		i, cfg := i, cfg
+
+		select {
+		case <-ctx.Done():
+			results[i] = Result{Config: cfg, Err: ctx.Err()}
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = d.dispatchOne(ctx, cfg)
+		}()
+	}
+
+	wg.Wait()
+	return results
+}
+
+// totalCapacity sums the advertised AvailableThreads across workers, the
+// cluster's actual concurrent-trial budget.
+func totalCapacity(workers []*WorkerNode) int {
+	total := 0
+	for _, w := range workers {
+		total += w.AvailableThreads
+	}
+	return total
+}
+
+func (d *Dispatcher) dispatchOne(ctx context.Context, cfg orchestrator.RunConfig) Result {
+	var lastErr error
+
+	for attempt := 0; attempt <= d.MaxRetries; attempt++ {
+		workers := d.Registry.Healthy()
+		worker, err := d.Scheduler.Select(workers, cfg)
+		if err != nil {
+			return Result{Config: cfg, Err: err}
+		}
+
+		d.Registry.acquire(worker.ID)
+		result, err := d.runTrial(ctx, worker, cfg)
+		d.Registry.release(worker.ID)
+
+		if err == nil {
+			return Result{Config: cfg, WorkerID: worker.ID, Result: result}
+		}
+
+		lastErr = fmt.Errorf("worker %s: %w", worker.ID, err)
+		d.Registry.MarkUnhealthy(worker.ID)
+	}
+
+	return Result{Config: cfg, Err: fmt.Errorf("run %s: exhausted %d attempt(s): %w", cfg.RunID, d.MaxRetries+1, lastErr)}
+}
+
+// runOn connects to worker and executes cfg against it, pulling spikes and
+// weights back to DataDir over the gRPC control plane and writing a
+// manifest alongside them exactly as the local orchestrator.Pool does.
+func (d *Dispatcher) runOn(ctx context.Context, worker *WorkerNode, cfg orchestrator.RunConfig) (orchestrator.RunResult, error) {
+	client, err := simclient.Dial(ctx, worker.URL)
+	if err != nil {
+		return orchestrator.RunResult{}, err
+	}
+	defer client.Close()
+
+	runDir := orchestrator.RunDir(d.DataDir, cfg)
+	result, err := simclient.RunTrial(ctx, client, cfg, runDir)
+	if err != nil {
+		return orchestrator.RunResult{}, err
+	}
+
+	if _, err := orchestrator.WriteManifest(d.RustDir, "", cfg, result); err != nil {
+		return orchestrator.RunResult{}, fmt.Errorf("writing manifest for %s: %w", cfg.RunID, err)
+	}
+	return result, nil
+}
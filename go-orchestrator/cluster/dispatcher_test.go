@@ -0,0 +1,124 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/orchestrator"
+)
+
+// newTestDispatcher builds a Dispatcher wired to registry and runTrial,
+// skipping NewDispatcher's real gRPC default so tests never dial out.
+func newTestDispatcher(registry *Registry, scheduler Scheduler, runTrial func(ctx context.Context, worker *WorkerNode, cfg orchestrator.RunConfig) (orchestrator.RunResult, error)) *Dispatcher {
+	return &Dispatcher{Registry: registry, Scheduler: scheduler, MaxRetries: 2, runTrial: runTrial}
+}
+
+func TestDispatchAllRunsEveryConfig(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("w1", "w1:1", 2)
+	registry.Register("w2", "w2:1", 2)
+
+	var ran int32
+	d := newTestDispatcher(registry, LeastLoaded{}, func(ctx context.Context, worker *WorkerNode, cfg orchestrator.RunConfig) (orchestrator.RunResult, error) {
+		atomic.AddInt32(&ran, 1)
+		return orchestrator.RunResult{}, nil
+	})
+
+	configs := make([]orchestrator.RunConfig, 10)
+	for i := range configs {
+		configs[i] = orchestrator.RunConfig{RunID: fmt.Sprintf("run-%d", i)}
+	}
+
+	results := d.DispatchAll(context.Background(), configs)
+	if len(results) != len(configs) {
+		t.Fatalf("DispatchAll() returned %d results, want %d", len(results), len(configs))
+	}
+	for _, r := range results {
+		if r.Err != nil {
+			t.Errorf("config %s: unexpected error %v", r.Config.RunID, r.Err)
+		}
+	}
+	if got := atomic.LoadInt32(&ran); int(got) != len(configs) {
+		t.Errorf("runTrial invoked %d times, want %d", got, len(configs))
+	}
+}
+
+// firstByID is a deterministic Scheduler for tests: it always picks the
+// worker with the lexicographically smallest ID still in the healthy set,
+// so a retry after a failure is guaranteed to land on a different worker.
+type firstByID struct{}
+
+func (firstByID) Select(workers []*WorkerNode, _ orchestrator.RunConfig) (*WorkerNode, error) {
+	if len(workers) == 0 {
+		return nil, fmt.Errorf("cluster: no healthy workers available")
+	}
+	best := workers[0]
+	for _, w := range workers[1:] {
+		if w.ID < best.ID {
+			best = w
+		}
+	}
+	return best, nil
+}
+
+func TestDispatchOneRetriesOnAnotherWorker(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("flaky", "flaky:1", 1)
+	registry.Register("solid", "solid:1", 1)
+
+	d := newTestDispatcher(registry, firstByID{}, func(ctx context.Context, worker *WorkerNode, cfg orchestrator.RunConfig) (orchestrator.RunResult, error) {
+		if worker.ID == "flaky" {
+			return orchestrator.RunResult{}, fmt.Errorf("boom")
+		}
+		return orchestrator.RunResult{ExitCode: 0}, nil
+	})
+
+	result := d.dispatchOne(context.Background(), orchestrator.RunConfig{RunID: "r1"})
+	if result.Err != nil {
+		t.Fatalf("dispatchOne() error: %v", result.Err)
+	}
+	if result.WorkerID != "solid" {
+		t.Errorf("dispatchOne() ran on %q, want %q", result.WorkerID, "solid")
+	}
+
+	if _, err := registry.Get("flaky"); err != nil {
+		t.Fatalf("Get(flaky) error: %v", err)
+	}
+	healthy := registry.Healthy()
+	for _, w := range healthy {
+		if w.ID == "flaky" {
+			t.Error("flaky worker should have been marked unhealthy after its failure")
+		}
+	}
+}
+
+func TestDispatchOneExhaustsRetries(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("w1", "w1:1", 1)
+	registry.Register("w2", "w2:1", 1)
+	registry.Register("w3", "w3:1", 1)
+
+	calls := 0
+	d := newTestDispatcher(registry, LeastLoaded{}, func(ctx context.Context, worker *WorkerNode, cfg orchestrator.RunConfig) (orchestrator.RunResult, error) {
+		calls++
+		return orchestrator.RunResult{}, fmt.Errorf("boom")
+	})
+	d.MaxRetries = 2
+
+	result := d.dispatchOne(context.Background(), orchestrator.RunConfig{RunID: "r1"})
+	if result.Err == nil {
+		t.Fatal("dispatchOne() with always-failing workers: want error, got nil")
+	}
+	if calls != d.MaxRetries+1 {
+		t.Errorf("runTrial called %d times, want %d", calls, d.MaxRetries+1)
+	}
+}
+
+func TestTotalCapacitySumsAvailableThreads(t *testing.T) {
+	workers := []*WorkerNode{{AvailableThreads: 2}, {AvailableThreads: 3}}
+	if got := totalCapacity(workers); got != 5 {
+		t.Errorf("totalCapacity() = %d, want 5", got)
+	}
+}
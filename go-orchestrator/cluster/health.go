@@ -0,0 +1,35 @@
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// capacityResponse mirrors the JSON served by a worker's /health endpoint
+// (see cmd/worker).
+type capacityResponse struct {
+	SimAddr          string `json:"sim_addr"`
+	AvailableThreads int    `json:"available_threads"`
+}
+
+// Discover polls healthURL (a worker's http://host:port/health endpoint),
+// registering or re-registering it under id using the gRPC address it
+// reports.
+func (r *Registry) Discover(id, healthURL string) error {
+	resp, err := http.Get(healthURL)
+	if err != nil {
+		r.MarkUnhealthy(id)
+		return fmt.Errorf("cluster: health check for %s: %w", id, err)
+	}
+	defer resp.Body.Close()
+
+	var cap capacityResponse
+	if err := json.NewDecoder(resp.Body).Decode(&cap); err != nil {
+		r.MarkUnhealthy(id)
+		return fmt.Errorf("cluster: decoding health response from %s: %w", id, err)
+	}
+
+	r.Register(id, cap.SimAddr, cap.AvailableThreads)
+	return nil
+}
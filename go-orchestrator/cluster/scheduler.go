@@ -0,0 +1,59 @@
+package cluster
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/orchestrator"
+)
+
+// Scheduler picks which healthy worker should run cfg next.
+type Scheduler interface {
+	Select(workers []*WorkerNode, cfg orchestrator.RunConfig) (*WorkerNode, error)
+}
+
+// LeastLoaded routes each trial to whichever healthy worker currently has
+// the lowest in-flight-to-capacity ratio.
+type LeastLoaded struct{}
+
+// Select implements Scheduler.
+func (LeastLoaded) Select(workers []*WorkerNode, _ orchestrator.RunConfig) (*WorkerNode, error) {
+	if len(workers) == 0 {
+		return nil, fmt.Errorf("cluster: no healthy workers available")
+	}
+
+	best := workers[0]
+	for _, w := range workers[1:] {
+		if w.Load() < best.Load() {
+			best = w
+		}
+	}
+	return best, nil
+}
+
+// StickyBySeed always routes a given RNG seed to the same worker (as long
+// as it stays healthy), so re-running an experiment reproduces results on
+// the same hardware rather than a different node's floating-point
+// quirks changing the outcome.
+type StickyBySeed struct{}
+
+// Select implements Scheduler.
+func (StickyBySeed) Select(workers []*WorkerNode, cfg orchestrator.RunConfig) (*WorkerNode, error) {
+	if len(workers) == 0 {
+		return nil, fmt.Errorf("cluster: no healthy workers available")
+	}
+
+	// Rendezvous hashing: score every worker by hash(seed, ID) and take the
+	// highest, so the choice depends neither on the order of workers nor on
+	// which other workers are currently healthy.
+	var best *WorkerNode
+	var bestScore uint32
+	for _, w := range workers {
+		h := fnv.New32a()
+		fmt.Fprintf(h, "%d/%s", cfg.Seed, w.ID)
+		if score := h.Sum32(); best == nil || score > bestScore || (score == bestScore && w.ID < best.ID) {
+			best, bestScore = w, score
+		}
+	}
+	return best, nil
+}
@@ -0,0 +1,52 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/orchestrator"
+)
+
+func TestLeastLoadedPicksLowestRatio(t *testing.T) {
+	idle := &WorkerNode{ID: "idle", AvailableThreads: 4}
+	busy := &WorkerNode{ID: "busy", AvailableThreads: 4, inFlight: 3}
+	zeroCap := &WorkerNode{ID: "zero-cap", AvailableThreads: 0}
+
+	got, err := (LeastLoaded{}).Select([]*WorkerNode{busy, zeroCap, idle}, orchestrator.RunConfig{})
+	if err != nil {
+		t.Fatalf("Select() error: %v", err)
+	}
+	if got.ID != "idle" {
+		t.Errorf("Select() picked %q, want %q", got.ID, "idle")
+	}
+}
+
+func TestLeastLoadedNoWorkers(t *testing.T) {
+	if _, err := (LeastLoaded{}).Select(nil, orchestrator.RunConfig{}); err == nil {
+		t.Error("Select() with no workers: want error, got nil")
+	}
+}
+
+func TestStickyBySeedIsDeterministic(t *testing.T) {
+	workers := []*WorkerNode{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+	cfg := orchestrator.RunConfig{Seed: 42}
+
+	first, err := (StickyBySeed{}).Select(workers, cfg)
+	if err != nil {
+		t.Fatalf("Select() error: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		again, err := (StickyBySeed{}).Select(workers, cfg)
+		if err != nil {
+			t.Fatalf("Select() error: %v", err)
+		}
+		if again.ID != first.ID {
+			t.Fatalf("Select() picked %q then %q for the same seed", first.ID, again.ID)
+		}
+	}
+}
+
+func TestStickyBySeedNoWorkers(t *testing.T) {
+	if _, err := (StickyBySeed{}).Select(nil, orchestrator.RunConfig{}); err == nil {
+		t.Error("Select() with no workers: want error, got nil")
+	}
+}
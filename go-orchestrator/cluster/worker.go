@@ -0,0 +1,152 @@
+// Package cluster turns the single-host orchestrator into a scalable
+// experiment cluster: remote worker nodes register their capacity, and
+// trials are dispatched to them over the same gRPC control plane used
+// locally, with re-queueing on worker failure.
+package cluster
+
+import (
+	"fmt"
+	"sync"
+)
+
+// WorkerNode is one remote simulation worker, advertising where to reach
+// it and how many concurrent trials it can run.
+type WorkerNode struct {
+	ID               string
+	URL              string
+	AvailableThreads int
+
+	// mu guards inFlight, which Load() reads (from the scheduler's
+	// goroutine) concurrently with acquire/release (from Dispatcher's
+	// worker-pool goroutines).
+	mu sync.Mutex
+
+	// inFlight is the number of trials this registry has currently
+	// dispatched to the worker and not yet seen complete or fail.
+	inFlight int
+
+	// healthy is false once a dispatch to this worker has failed, until a
+	// health check marks it healthy again.
+	healthy bool
+}
+
+// Load returns how busy the worker is relative to its advertised capacity,
+// in [0, +inf). Schedulers use this to prefer idler workers.
+func (w *WorkerNode) Load() float64 {
+	if w.AvailableThreads <= 0 {
+		return 1 // treat a worker that advertised zero capacity as fully loaded
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return float64(w.inFlight) / float64(w.AvailableThreads)
+}
+
+// acquire claims a slot for a dispatched trial.
+func (w *WorkerNode) acquire() {
+	w.mu.Lock()
+	w.inFlight++
+	w.mu.Unlock()
+}
+
+// release frees a slot once a trial completes or fails.
+func (w *WorkerNode) release() {
+	w.mu.Lock()
+	if w.inFlight > 0 {
+		w.inFlight--
+	}
+	w.mu.Unlock()
+}
+
+// Registry tracks the set of known workers and their current load. Safe
+// for concurrent use.
+type Registry struct {
+	mu      sync.Mutex
+	workers map[string]*WorkerNode
+}
+
+// NewRegistry returns an empty worker registry.
+func NewRegistry() *Registry {
+	return &Registry{workers: make(map[string]*WorkerNode)}
+}
+
+// Register adds or updates a worker's advertised capacity, marking it
+// healthy.
+func (r *Registry) Register(id, url string, availableThreads int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.workers[id] = &WorkerNode{ID: id, URL: url, AvailableThreads: availableThreads, healthy: true}
+}
+
+// Unregister removes a worker, e.g. on a clean shutdown notification.
+func (r *Registry) Unregister(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.workers, id)
+}
+
+// Healthy returns every currently-healthy registered worker.
+func (r *Registry) Healthy() []*WorkerNode {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var out []*WorkerNode
+	for _, w := range r.workers {
+		if w.healthy {
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
+// MarkUnhealthy flags a worker as unavailable after a dispatch failure, so
+// the scheduler stops routing new trials to it until MarkHealthy is called.
+func (r *Registry) MarkUnhealthy(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if w, ok := r.workers[id]; ok {
+		w.healthy = false
+	}
+}
+
+// MarkHealthy restores a worker to the healthy pool, typically after a
+// health check succeeds again.
+func (r *Registry) MarkHealthy(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if w, ok := r.workers[id]; ok {
+		w.healthy = true
+	}
+}
+
+// acquire increments a worker's in-flight count, claiming a slot for a
+// dispatched trial.
+func (r *Registry) acquire(id string) {
+	r.mu.Lock()
+	w, ok := r.workers[id]
+	r.mu.Unlock()
+	if ok {
+		w.acquire()
+	}
+}
+
+// release decrements a worker's in-flight count once a trial completes or
+// fails.
+func (r *Registry) release(id string) {
+	r.mu.Lock()
+	w, ok := r.workers[id]
+	r.mu.Unlock()
+	if ok {
+		w.release()
+	}
+}
+
+// Get returns the worker registered under id, if any.
+func (r *Registry) Get(id string) (*WorkerNode, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	w, ok := r.workers[id]
+	if !ok {
+		return nil, fmt.Errorf("cluster: no worker registered with id %q", id)
+	}
+	return w, nil
+}
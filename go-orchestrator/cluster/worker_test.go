@@ -0,0 +1,35 @@
+package cluster
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestWorkerNodeConcurrentAcquireReleaseLoad exercises Load() running
+// concurrently with acquire/release, the exact access pattern the scheduler
+// and Dispatcher use against a live WorkerNode, under the race detector.
+func TestWorkerNodeConcurrentAcquireReleaseLoad(t *testing.T) {
+	w := &WorkerNode{ID: "w", AvailableThreads: 4}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.acquire()
+			_ = w.Load()
+			w.release()
+		}()
+	}
+	wg.Wait()
+
+	if w.inFlight != 0 {
+		t.Errorf("inFlight = %d after all acquire/release pairs completed, want 0", w.inFlight)
+	}
+}
+
+func TestRegistryAcquireReleaseUnknownWorkerIsNoop(t *testing.T) {
+	r := NewRegistry()
+	r.acquire("missing")
+	r.release("missing")
+}
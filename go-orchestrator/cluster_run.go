@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/cluster"
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/orchestrator"
+)
+
+// runCluster discovers the workers behind workerHealthURLs, then dispatches
+// every config across them with Dispatcher.DispatchAll, demonstrating the
+// cluster package's distributed use case (-workers) alongside the flat
+// single-host sweep.
+func runCluster(ctx context.Context, configs []orchestrator.RunConfig, workerHealthURLs []string, schedulerName, dataDir, rustDir string) error {
+	registry := cluster.NewRegistry()
+	for i, healthURL := range workerHealthURLs {
+		id := fmt.Sprintf("worker-%d", i)
+		if err := registry.Discover(id, healthURL); err != nil {
+			return fmt.Errorf("registering %s: %w", healthURL, err)
+		}
+	}
+
+	scheduler, err := newScheduler(schedulerName)
+	if err != nil {
+		return err
+	}
+
+	dispatcher := cluster.NewDispatcher(registry, scheduler, dataDir, rustDir)
+	results := dispatcher.DispatchAll(ctx, configs)
+
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+			fmt.Printf("%s failed: %v\n", r.Config.RunID, r.Err)
+			continue
+		}
+		fmt.Printf("%s ran on %s\n", r.Config.RunID, r.WorkerID)
+	}
+	if failed > 0 {
+		return fmt.Errorf("cluster dispatch completed with %d/%d failure(s)", failed, len(results))
+	}
+	return nil
+}
+
+// newScheduler resolves the -scheduler flag to a cluster.Scheduler.
+func newScheduler(name string) (cluster.Scheduler, error) {
+	switch name {
+	case "", "least-loaded":
+		return cluster.LeastLoaded{}, nil
+	case "sticky-seed":
+		return cluster.StickyBySeed{}, nil
+	default:
+		return nil, fmt.Errorf("unknown -scheduler %q (want least-loaded or sticky-seed)", name)
+	}
+}
+
+// splitWorkers parses the comma-separated -workers flag into individual
+// health endpoint URLs, dropping blank entries.
+func splitWorkers(flagValue string) []string {
+	var out []string
+	for _, part := range strings.Split(flagValue, ",") {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
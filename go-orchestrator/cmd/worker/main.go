@@ -0,0 +1,60 @@
+// Command worker wraps a Rust simulation daemon and reports its capacity
+// and health, so an orchestrator's cluster.Dispatcher can schedule trials
+// onto it.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/simclient"
+)
+
+// capacityResponse is served at /health so the orchestrator (or a plain
+// curl) can check a worker's advertised thread count and liveness.
+type capacityResponse struct {
+	SimAddr          string `json:"sim_addr"`
+	AvailableThreads int    `json:"available_threads"`
+}
+
+func main() {
+	rustDir := flag.String("rust-dir", "../rust-core", "path to the rust-core checkout this worker runs")
+	simAddr := flag.String("sim-addr", "127.0.0.1:7711", "address the Rust simulation daemon listens on")
+	healthAddr := flag.String("health-addr", "127.0.0.1:7712", "address this worker's health/capacity endpoint listens on")
+	threads := flag.Int("threads", runtime.NumCPU(), "threads this worker advertises as available capacity")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Printf("Starting sim daemon at %s from %s\n", *simAddr, *rustDir)
+	daemon, err := simclient.NewDaemonRunner(ctx, *rustDir, *simAddr)
+	if err != nil {
+		fmt.Println("Failed to start sim daemon:", err)
+		os.Exit(1)
+	}
+	defer daemon.Close()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(capacityResponse{SimAddr: *simAddr, AvailableThreads: *threads})
+	})
+
+	server := &http.Server{Addr: *healthAddr, Handler: mux}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	fmt.Printf("Worker ready: sim=%s health=%s threads=%d\n", *simAddr, *healthAddr, *threads)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		fmt.Println("Health server error:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultGroup is the cast group used by AddLink when no group is given —
+// the common case of an unconditional edge.
+const DefaultGroup = "default"
+
+// edge is one outgoing link from a node, grouped under a cast group name so
+// a Decide node can select which group to follow at runtime. Edges within
+// the same group all fire together (a "cast").
+type edge struct {
+	to    string
+	group string
+}
+
+// Brain holds an experiment graph: named nodes, the edges between them, and
+// the shared memory artifacts flow through. Zero value is not usable; build
+// one with NewBrain.
+type Brain struct {
+	mu    sync.Mutex
+	nodes map[string]Node
+	links map[string][]edge
+	entry []string
+	end   map[string]bool
+
+	// Memory is shared state visible to every node via Runtime.Get/Set —
+	// spike trains, weight matrices, metrics, and any decision state a
+	// Decide node needs to pick its next cast group.
+	Memory map[string]any
+}
+
+// NewBrain returns an empty graph ready for AddNode/AddLink calls.
+func NewBrain() *Brain {
+	return &Brain{
+		nodes:  make(map[string]Node),
+		links:  make(map[string][]edge),
+		end:    make(map[string]bool),
+		Memory: make(map[string]any),
+	}
+}
+
+// AddNode registers a stage under name, overwriting any existing node with
+// that name.
+func (b *Brain) AddNode(name string, n Node) {
+	b.nodes[name] = n
+}
+
+// AddLink connects from -> to under group (DefaultGroup if empty). A
+// Decide node selects which group to follow next via Runtime.Cast.
+func (b *Brain) AddLink(from, to, group string) {
+	if group == "" {
+		group = DefaultGroup
+	}
+	b.links[from] = append(b.links[from], edge{to: to, group: group})
+}
+
+// AddEntryLink marks node as a starting point for Run.
+func (b *Brain) AddEntryLink(node string) {
+	b.entry = append(b.entry, node)
+}
+
+// AddEndLink marks node as terminal: once it completes, Run stops walking
+// past it even if it has outgoing links.
+func (b *Brain) AddEndLink(node string) {
+	b.end[node] = true
+}
+
+// Run walks the graph breadth-first from the entry nodes, following
+// whichever cast group each node selects (via Runtime.Cast) until every
+// active branch reaches an end node or one with no matching outgoing
+// links. Because a Decide node can route back to an already-visited node,
+// Run has no implicit iteration cap — callers that want one should track
+// it in Memory and have Decide route to an end node once reached.
+func (b *Brain) Run(ctx context.Context) error {
+	if len(b.entry) == 0 {
+		return fmt.Errorf("graph: no entry nodes registered")
+	}
+
+	frontier := append([]string(nil), b.entry...)
+	for len(frontier) > 0 {
+		var next []string
+		for _, name := range frontier {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			node, ok := b.nodes[name]
+			if !ok {
+				return fmt.Errorf("graph: node %q has no implementation", name)
+			}
+
+			rt := &Runtime{brain: b, node: name}
+			if err := node.Process(ctx, rt); err != nil {
+				return fmt.Errorf("graph: node %q: %w", name, err)
+			}
+
+			if b.end[name] {
+				continue
+			}
+
+			group := rt.castGroup
+			if group == "" {
+				group = DefaultGroup
+			}
+			for _, e := range b.links[name] {
+				if e.group == group {
+					next = append(next, e.to)
+				}
+			}
+		}
+		frontier = next
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+// Package graph models an experiment as a brain/neuron-graph: nodes are
+// stages (RustSimulate, AnalyzeSpikes, TuneWeights, Decide, Report), edges
+// carry artifacts between them via shared memory, and a Decide-style node
+// can route execution back to an earlier stage instead of the pipeline
+// being strictly one-shot.
+package graph
+
+import "context"
+
+// Node is one stage in an experiment graph. Process reads its inputs from
+// rt's shared memory, does its work, and writes outputs back before
+// returning.
+type Node interface {
+	Process(ctx context.Context, rt *Runtime) error
+}
+
+// NodeFunc adapts a plain function to the Node interface, for stages that
+// don't need their own type.
+type NodeFunc func(ctx context.Context, rt *Runtime) error
+
+// Process calls f.
+func (f NodeFunc) Process(ctx context.Context, rt *Runtime) error { return f(ctx, rt) }
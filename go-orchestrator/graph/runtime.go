@@ -0,0 +1,39 @@
+package graph
+
+// Runtime is passed to every Node.Process call. It exposes the graph's
+// shared memory and lets a Decide-style node choose which cast group of
+// outgoing links to follow next.
+type Runtime struct {
+	brain     *Brain
+	node      string
+	castGroup string
+}
+
+// Node returns the name of the node currently being processed.
+func (rt *Runtime) Node() string {
+	return rt.node
+}
+
+// Get reads a value from the graph's shared memory.
+func (rt *Runtime) Get(key string) (any, bool) {
+	rt.brain.mu.Lock()
+	defer rt.brain.mu.Unlock()
+	v, ok := rt.brain.Memory[key]
+	return v, ok
+}
+
+// Set writes a value to the graph's shared memory, visible to every
+// subsequent node.
+func (rt *Runtime) Set(key string, value any) {
+	rt.brain.mu.Lock()
+	defer rt.brain.mu.Unlock()
+	rt.brain.Memory[key] = value
+}
+
+// Cast selects which outgoing cast group this node's links should follow.
+// A Decide node calls this to route back to an earlier stage (e.g.
+// RustSimulate with adjusted hyperparameters) or forward to Report. If a
+// node never calls Cast, DefaultGroup is used.
+func (rt *Runtime) Cast(group string) {
+	rt.castGroup = group
+}
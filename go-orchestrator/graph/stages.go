@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/orchestrator"
+)
+
+// Shared-memory keys used by the stock stages below. A graph built purely
+// from custom Nodes is free to use its own keys instead.
+const (
+	MemRunConfig = "run_config"
+	MemRunResult = "run_result"
+	MemMetrics   = "metrics"
+)
+
+// CastRefine and CastDone are the cast groups a Decide node typically
+// chooses between: loop back to RustSimulate with adjusted hyperparameters,
+// or move on to Report.
+const (
+	CastRefine = "refine"
+	CastDone   = "done"
+)
+
+// RustSimulateNode runs one trial through a Runner and stores the
+// RunResult in Memory[MemRunResult] for AnalyzeSpikes to pick up.
+type RustSimulateNode struct {
+	Runner  orchestrator.Runner
+	DataDir string
+}
+
+// Process runs the config currently in Memory[MemRunConfig].
+func (n *RustSimulateNode) Process(ctx context.Context, rt *Runtime) error {
+	cfgVal, ok := rt.Get(MemRunConfig)
+	if !ok {
+		return fmt.Errorf("RustSimulate: no %q in memory", MemRunConfig)
+	}
+	cfg := cfgVal.(orchestrator.RunConfig)
+
+	result, err := n.Runner.Run(ctx, cfg, orchestrator.RunDir(n.DataDir, cfg))
+	if err != nil {
+		return fmt.Errorf("RustSimulate: %w", err)
+	}
+	rt.Set(MemRunResult, result)
+	return nil
+}
+
+// AnalyzeSpikesNode is a placeholder for spike-train analysis: it reads the
+// run's outputs and writes whatever metrics downstream nodes decide on.
+// Analyze is supplied by the caller so the graph package doesn't need to
+// know the metric format.
+type AnalyzeSpikesNode struct {
+	Analyze func(result orchestrator.RunResult) (map[string]float64, error)
+}
+
+// Process computes metrics for the most recent run result.
+func (n *AnalyzeSpikesNode) Process(ctx context.Context, rt *Runtime) error {
+	resultVal, ok := rt.Get(MemRunResult)
+	if !ok {
+		return fmt.Errorf("AnalyzeSpikes: no %q in memory", MemRunResult)
+	}
+	metrics, err := n.Analyze(resultVal.(orchestrator.RunResult))
+	if err != nil {
+		return fmt.Errorf("AnalyzeSpikes: %w", err)
+	}
+	rt.Set(MemMetrics, metrics)
+	return nil
+}
+
+// TuneWeightsNode adjusts the run config ahead of the next simulation,
+// e.g. nudging the learning rate based on the latest metrics. Tune is
+// supplied by the caller.
+type TuneWeightsNode struct {
+	Tune func(cfg orchestrator.RunConfig, metrics map[string]float64) orchestrator.RunConfig
+}
+
+// Process rewrites Memory[MemRunConfig] with the tuned config.
+func (n *TuneWeightsNode) Process(ctx context.Context, rt *Runtime) error {
+	cfgVal, ok := rt.Get(MemRunConfig)
+	if !ok {
+		return fmt.Errorf("TuneWeights: no %q in memory", MemRunConfig)
+	}
+	metricsVal, ok := rt.Get(MemMetrics)
+	if !ok {
+		return fmt.Errorf("TuneWeights: no %q in memory", MemMetrics)
+	}
+	rt.Set(MemRunConfig, n.Tune(cfgVal.(orchestrator.RunConfig), metricsVal.(map[string]float64)))
+	return nil
+}
+
+// DecideNode inspects the latest metrics and chooses whether to loop back
+// for another refinement pass (CastRefine) or move on to Report (CastDone).
+// Should is supplied by the caller.
+type DecideNode struct {
+	Should func(metrics map[string]float64) (refine bool)
+}
+
+// Process casts CastRefine or CastDone based on Should's verdict.
+func (n *DecideNode) Process(ctx context.Context, rt *Runtime) error {
+	metricsVal, ok := rt.Get(MemMetrics)
+	if !ok {
+		return fmt.Errorf("Decide: no %q in memory", MemMetrics)
+	}
+	if n.Should(metricsVal.(map[string]float64)) {
+		rt.Cast(CastRefine)
+	} else {
+		rt.Cast(CastDone)
+	}
+	return nil
+}
+
+// ReportNode is a terminal stage; Emit is supplied by the caller (print a
+// summary, write a file, post to a dashboard, etc).
+type ReportNode struct {
+	Emit func(metrics map[string]float64) error
+}
+
+// Process reports the final metrics.
+func (n *ReportNode) Process(ctx context.Context, rt *Runtime) error {
+	metricsVal, ok := rt.Get(MemMetrics)
+	if !ok {
+		return fmt.Errorf("Report: no %q in memory", MemMetrics)
+	}
+	return n.Emit(metricsVal.(map[string]float64))
+}
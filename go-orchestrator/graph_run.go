@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/graph"
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/orchestrator"
+)
+
+// maxRefinements bounds how many times runGraph will loop back to
+// RustSimulate before forwarding to Report regardless of the metrics,
+// since a Decide node has no implicit iteration cap of its own.
+const maxRefinements = 3
+
+// targetSpikeCount is the spike count runGraph's default tuning tries to
+// settle under: each refinement pass that overshoots it halves the
+// learning rate before re-simulating.
+const targetSpikeCount = 5000
+
+// runGraph wires the stock graph stages into a single closed-loop
+// refinement experiment — simulate, analyze spikes, tune the learning
+// rate, and either loop back or report — demonstrating the graph
+// package's iterative use case (-graph) alongside the flat sweep.
+func runGraph(ctx context.Context, cfg orchestrator.RunConfig, runner orchestrator.Runner, dataDir string) error {
+	iteration := 0
+
+	brain := graph.NewBrain()
+	brain.Memory[graph.MemRunConfig] = cfg
+
+	brain.AddNode("simulate", &graph.RustSimulateNode{Runner: runner, DataDir: dataDir})
+	brain.AddNode("analyze", &graph.AnalyzeSpikesNode{Analyze: countSpikes})
+	brain.AddNode("tune", &graph.TuneWeightsNode{Tune: halveLearningRateIfNoisy})
+	brain.AddNode("decide", &graph.DecideNode{Should: func(metrics map[string]float64) bool {
+		iteration++
+		return iteration <= maxRefinements && metrics["spike_count"] > targetSpikeCount
+	}})
+	brain.AddNode("report", &graph.ReportNode{Emit: func(metrics map[string]float64) error {
+		fmt.Printf("Graph run finished after %d iteration(s): %+v\n", iteration, metrics)
+		return nil
+	}})
+
+	brain.AddEntryLink("simulate")
+	brain.AddLink("simulate", "analyze", "")
+	brain.AddLink("analyze", "decide", "")
+	brain.AddLink("decide", "tune", graph.CastRefine)
+	brain.AddLink("tune", "simulate", "")
+	brain.AddLink("decide", "report", graph.CastDone)
+	brain.AddEndLink("report")
+
+	return brain.Run(ctx)
+}
+
+// countSpikes is the default AnalyzeSpikesNode.Analyze: it counts the data
+// rows in the run's spikes.csv as a stand-in metric for how active the
+// simulation was.
+func countSpikes(result orchestrator.RunResult) (map[string]float64, error) {
+	f, err := os.Open(filepath.Join(result.RunDir, "spikes.csv"))
+	if err != nil {
+		return nil, fmt.Errorf("counting spikes: %w", err)
+	}
+	defer f.Close()
+
+	lines := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("counting spikes: %w", err)
+	}
+
+	count := lines
+	if count > 0 {
+		count-- // drop the header row
+	}
+	return map[string]float64{"spike_count": float64(count)}, nil
+}
+
+// halveLearningRateIfNoisy is the default TuneWeightsNode.Tune: it halves
+// the learning rate whenever the last run produced more spikes than
+// targetSpikeCount, leaving it unchanged otherwise.
+func halveLearningRateIfNoisy(cfg orchestrator.RunConfig, metrics map[string]float64) orchestrator.RunConfig {
+	if metrics["spike_count"] > targetSpikeCount {
+		cfg.LearningRate /= 2
+	}
+	return cfg
+}
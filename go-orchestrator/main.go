@@ -1,49 +1,124 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
+	"io"
 	"os"
-	"os/exec"
+	"os/signal"
 	"path/filepath"
+	"runtime"
+
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/orchestrator"
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/toolchain"
 )
 
 func main() {
+	specPath := flag.String("spec", "experiment.yaml", "path to the experiment spec (YAML or TOML)")
+	jobs := flag.Int("jobs", runtime.NumCPU(), "number of trials to run concurrently")
+	progressPath := flag.String("progress-file", "", "write structured JSON progress events here (default: discard)")
+	projectRootFlag := flag.String("project-root", "..", "repository root; rust-dir and data-dir default relative to this")
+	rustDirFlag := flag.String("rust-dir", "", "path to the rust-core checkout (default: <project-root>/rust-core)")
+	dataDirFlag := flag.String("data-dir", "", "directory to write per-run outputs under (default: <project-root>/data/raw)")
+	graphMode := flag.Bool("graph", false, "run a single closed-loop refinement experiment (simulate/analyze/tune/decide/report) via the graph package instead of the flat sweep")
+	workersFlag := flag.String("workers", "", "comma-separated worker health endpoints (http://host:port/health); dispatches the sweep across them via the cluster package instead of running it locally")
+	schedulerFlag := flag.String("scheduler", "least-loaded", "cluster scheduling policy when -workers is set: least-loaded or sticky-seed")
+	flag.Parse()
+
 	fmt.Println("=== Neuromorphic Experiment Orchestrator ===")
 
-	// Resolve project root (go-orchestrator/..)
-	projectRoot, err := filepath.Abs("..")
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	projectRoot, err := filepath.Abs(*projectRootFlag)
 	if err != nil {
 		panic(err)
 	}
+	rustDir := *rustDirFlag
+	if rustDir == "" {
+		rustDir = filepath.Join(projectRoot, "rust-core")
+	}
+	dataDir := *dataDirFlag
+	if dataDir == "" {
+		dataDir = filepath.Join(projectRoot, "data", "raw")
+	}
 
-	// --- Run Rust simulation ---
-	rustDir := filepath.Join(projectRoot, "rust-core")
-	rustCmd := exec.Command("cargo", "run")
-	rustCmd.Dir = rustDir
-	rustCmd.Stdout = os.Stdout
-	rustCmd.Stderr = os.Stderr
+	tc, err := toolchain.Find()
+	if err != nil {
+		fmt.Println("Toolchain preflight failed:", err)
+		os.Exit(1)
+	}
+	if err := toolchain.ValidateRustCore(rustDir); err != nil {
+		fmt.Println("Toolchain preflight failed:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Using cargo %s at %s\n", tc.Version, tc.CargoPath)
 
-	fmt.Println("Running Rust simulation...")
-	if err := rustCmd.Run(); err != nil {
-		fmt.Println("Rust simulation failed:", err)
-		return
+	spec, err := orchestrator.LoadSpec(*specPath)
+	if err != nil {
+		fmt.Println("Failed to load experiment spec:", err)
+		os.Exit(1)
 	}
 
-	// --- Verify outputs ---
-	spikes := filepath.Join(projectRoot, "data", "raw", "spikes.csv")
-	weights := filepath.Join(projectRoot, "data", "raw", "weights.csv")
+	configs := spec.Expand()
+	fmt.Printf("Expanded %q into %d run(s) across %d worker(s)\n", spec.Name, len(configs), *jobs)
 
-	if _, err := os.Stat(spikes); err == nil {
-		fmt.Println("✓ spikes.csv found")
-	} else {
-		fmt.Println("✗ spikes.csv missing")
+	progressW := io.Discard
+	if *progressPath != "" {
+		f, err := os.Create(*progressPath)
+		if err != nil {
+			fmt.Println("Failed to open progress file:", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		progressW = f
 	}
 
-	if _, err := os.Stat(weights); err == nil {
-		fmt.Println("✓ weights.csv found")
-	} else {
-		fmt.Println("✗ weights.csv missing")
+	runner := orchestrator.NewLocalRunner(rustDir)
+	runner.CargoPath = tc.CargoPath
+
+	if *graphMode {
+		if len(configs) == 0 {
+			fmt.Println("Graph mode requires at least one resolved run config")
+			os.Exit(1)
+		}
+		if err := runGraph(ctx, configs[0], runner, dataDir); err != nil {
+			fmt.Println("Graph run failed:", err)
+			os.Exit(1)
+		}
+		fmt.Println("Graph run completed.")
+		return
+	}
+
+	if *workersFlag != "" {
+		if err := runCluster(ctx, configs, splitWorkers(*workersFlag), *schedulerFlag, dataDir, rustDir); err != nil {
+			fmt.Println("Cluster dispatch failed:", err)
+			os.Exit(1)
+		}
+		fmt.Println("Cluster dispatch completed.")
+		return
+	}
+
+	pool := orchestrator.NewPool(runner, *jobs, dataDir, rustDir, orchestrator.NewProgressReporter(progressW))
+
+	results, runErr := pool.RunAll(ctx, configs)
+
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+			fmt.Printf("%s failed: %v\n", r.Config.RunID, r.Err)
+		}
 	}
 
-	fmt.Println("Experiment completed successfully.")
-}
\ No newline at end of file
+	if runErr != nil {
+		fmt.Println("Sweep interrupted:", runErr)
+		os.Exit(1)
+	}
+	if failed > 0 {
+		fmt.Printf("Experiment sweep completed with %d/%d failure(s).\n", failed, len(configs))
+		os.Exit(1)
+	}
+	fmt.Println("Experiment sweep completed.")
+}
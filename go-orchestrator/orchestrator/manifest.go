@@ -0,0 +1,138 @@
+package orchestrator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Manifest is written alongside every run's outputs so the run can be
+// re-executed bit-for-bit later: the resolved config, the exact rust-core
+// revision and build used, and checksums of what it produced.
+type Manifest struct {
+	RunID        string            `json:"run_id"`
+	Config       RunConfig         `json:"config"`
+	RustCommit   string            `json:"rust_commit"`
+	CargoBuild   string            `json:"cargo_build_hash"`
+	StartedAt    time.Time         `json:"started_at"`
+	EndedAt      time.Time         `json:"ended_at"`
+	ExitCode     int               `json:"exit_code"`
+	OutputSHA256 map[string]string `json:"output_sha256"`
+}
+
+// WriteManifest assembles a Manifest for result and writes it as
+// manifest.json inside result.RunDir. cargoPath should be the toolchain
+// resolved by toolchain.Find() (or "cargo" for a bare PATH lookup), so the
+// captured build hash reflects the binary that actually ran the trial.
+func WriteManifest(rustDir, cargoPath string, cfg RunConfig, result RunResult) (*Manifest, error) {
+	rustCommit, err := gitCommit(rustDir)
+	if err != nil {
+		rustCommit = "unknown: " + err.Error()
+	}
+
+	cargoBuild, err := cargoBuildHash(cargoPath)
+	if err != nil {
+		cargoBuild = "unknown: " + err.Error()
+	}
+
+	sums, err := outputChecksums(result.RunDir)
+	if err != nil {
+		return nil, fmt.Errorf("hashing outputs for %s: %w", cfg.RunID, err)
+	}
+
+	m := &Manifest{
+		RunID:        cfg.RunID,
+		Config:       cfg,
+		RustCommit:   rustCommit,
+		CargoBuild:   cargoBuild,
+		StartedAt:    result.StartedAt,
+		EndedAt:      result.EndedAt,
+		ExitCode:     result.ExitCode,
+		OutputSHA256: sums,
+	}
+
+	path := filepath.Join(result.RunDir, "manifest.json")
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("creating manifest %s: %w", path, err)
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(m); err != nil {
+		return nil, fmt.Errorf("writing manifest %s: %w", path, err)
+	}
+	return m, nil
+}
+
+// gitCommit returns the short commit hash of the rust-core checkout at dir.
+func gitCommit(dir string) (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--short", "HEAD")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// cargoBuildHash returns the hex SHA-256 of the `cargo --version` output of
+// the toolchain that produced the binary, so a later re-run can flag drift
+// even when the source is
+// unchanged (e.g. a rustc point release).
+func cargoBuildHash(cargoPath string) (string, error) {
+	if cargoPath == "" {
+		cargoPath = "cargo"
+	}
+	out, err := exec.Command(cargoPath, "--version").Output()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(out)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// outputChecksums hashes every file directly under runDir (spikes.csv,
+// weights.csv, and anything else the run produced) except manifest.json
+// itself.
+func outputChecksums(runDir string) (map[string]string, error) {
+	entries, err := os.ReadDir(runDir)
+	if err != nil {
+		return nil, err
+	}
+
+	sums := make(map[string]string)
+	for _, e := range entries {
+		if e.IsDir() || e.Name() == "manifest.json" {
+			continue
+		}
+		sum, err := sha256File(filepath.Join(runDir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		sums[e.Name()] = sum
+	}
+	return sums, nil
+}
+
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,158 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// defaultHeartbeatInterval is how often a running trial emits
+// EventHeartbeat when Pool.HeartbeatInterval is left unset.
+const defaultHeartbeatInterval = 10 * time.Second
+
+// Pool runs many RunConfigs concurrently against a Runner, bounded to Jobs
+// simultaneous trials. Each trial gets its own prefixed stdout/stderr and
+// emits structured progress events so callers can follow a sweep without
+// scraping interleaved log text.
+type Pool struct {
+	Runner Runner
+	Jobs   int
+
+	DataDir string
+	RustDir string
+	// CargoPath is threaded through to WriteManifest so the recorded
+	// build hash matches the cargo binary the runner actually used.
+	CargoPath string
+
+	// HeartbeatInterval controls how often a running trial emits
+	// EventHeartbeat, so a long simulation doesn't look stalled. Defaults
+	// to defaultHeartbeatInterval if zero.
+	HeartbeatInterval time.Duration
+
+	Progress *ProgressReporter
+}
+
+// NewPool builds a Pool bounded to jobs concurrent trials, defaulting to 1
+// if jobs is non-positive. If runner is a *LocalRunner, its CargoPath is
+// reused for manifest recording.
+func NewPool(runner Runner, jobs int, dataDir, rustDir string, progress *ProgressReporter) *Pool {
+	if jobs < 1 {
+		jobs = 1
+	}
+	p := &Pool{Runner: runner, Jobs: jobs, DataDir: dataDir, RustDir: rustDir, Progress: progress}
+	if local, ok := runner.(*LocalRunner); ok {
+		p.CargoPath = local.CargoPath
+	}
+	return p
+}
+
+// poolResult pairs a RunConfig with its outcome so RunAll can report
+// per-run manifests after the pool drains.
+type poolResult struct {
+	Config RunConfig
+	Result RunResult
+	Err    error
+}
+
+// RunAll executes every config, at most p.Jobs at a time, and writes a
+// manifest for each completed run. If ctx is cancelled (e.g. Ctrl-C), all
+// in-flight cargo processes are torn down and RunAll returns ctx.Err()
+// once the pool has drained.
+func (p *Pool) RunAll(ctx context.Context, configs []RunConfig) ([]poolResult, error) {
+	sem := make(chan struct{}, p.Jobs)
+	results := make([]poolResult, len(configs))
+
+	var stdoutMu, stderrMu sync.Mutex
+	var wg sync.WaitGroup
+
+	for i, cfg := range configs {
+		i, cfg := i, cfg
+
+		select {
+		case <-ctx.Done():
+			results[i] = poolResult{Config: cfg, Err: ctx.Err()}
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = p.runOne(ctx, cfg, &stdoutMu, &stderrMu)
+		}()
+	}
+
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return results, ctx.Err()
+	}
+	return results, nil
+}
+
+func (p *Pool) runOne(ctx context.Context, cfg RunConfig, stdoutMu, stderrMu *sync.Mutex) poolResult {
+	prefix := fmt.Sprintf("[%s] ", cfg.RunID)
+	outW := newPrefixWriter(os.Stdout, stdoutMu, prefix)
+	errW := newPrefixWriter(os.Stderr, stderrMu, prefix)
+
+	p.Progress.Emit(ProgressEvent{Type: EventStarted, RunID: cfg.RunID})
+
+	stopHeartbeat := p.startHeartbeat(cfg.RunID)
+	defer stopHeartbeat()
+
+	local, ok := p.Runner.(*LocalRunner)
+	if ok {
+		// Give this trial its own prefixed writers without mutating the
+		// shared LocalRunner used by other goroutines.
+		runner := &LocalRunner{RustDir: local.RustDir, CargoPath: local.CargoPath, Stdout: outW, Stderr: errW}
+		result, err := runner.Run(ctx, cfg, RunDir(p.DataDir, cfg))
+		outW.Flush()
+		errW.Flush()
+		return p.report(cfg, result, err)
+	}
+
+	result, err := p.Runner.Run(ctx, cfg, RunDir(p.DataDir, cfg))
+	return p.report(cfg, result, err)
+}
+
+// startHeartbeat emits EventHeartbeat for runID on a ticker until the
+// returned stop function is called.
+func (p *Pool) startHeartbeat(runID string) (stop func()) {
+	interval := p.HeartbeatInterval
+	if interval <= 0 {
+		interval = defaultHeartbeatInterval
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.Progress.Emit(ProgressEvent{Type: EventHeartbeat, RunID: runID})
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+func (p *Pool) report(cfg RunConfig, result RunResult, err error) poolResult {
+	if err != nil {
+		p.Progress.Emit(ProgressEvent{Type: EventFailed, RunID: cfg.RunID, Error: err.Error()})
+		return poolResult{Config: cfg, Err: err}
+	}
+
+	if _, mErr := WriteManifest(p.RustDir, p.CargoPath, cfg, result); mErr != nil {
+		p.Progress.Emit(ProgressEvent{Type: EventFailed, RunID: cfg.RunID, Error: mErr.Error()})
+		return poolResult{Config: cfg, Result: result, Err: mErr}
+	}
+
+	p.Progress.Emit(ProgressEvent{Type: EventFinished, RunID: cfg.RunID, ExitCode: result.ExitCode})
+	return poolResult{Config: cfg, Result: result}
+}
@@ -0,0 +1,111 @@
+package orchestrator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// EventType enumerates the structured progress events emitted for each run,
+// so a TUI or CI job can follow a sweep without scraping log text.
+type EventType string
+
+const (
+	EventStarted   EventType = "started"
+	EventHeartbeat EventType = "heartbeat"
+	EventFinished  EventType = "finished"
+	EventFailed    EventType = "failed"
+)
+
+// ProgressEvent is one JSON line on the progress side channel.
+type ProgressEvent struct {
+	Type      EventType `json:"type"`
+	RunID     string    `json:"run_id"`
+	Timestamp time.Time `json:"timestamp"`
+	ExitCode  int       `json:"exit_code,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// ProgressReporter writes ProgressEvents as newline-delimited JSON to an
+// underlying writer. It is safe for concurrent use by multiple workers.
+type ProgressReporter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewProgressReporter wraps w as a progress side channel.
+func NewProgressReporter(w io.Writer) *ProgressReporter {
+	return &ProgressReporter{w: w}
+}
+
+// Emit stamps ev with the current time and writes it as a single JSON
+// line.
+func (p *ProgressReporter) Emit(ev ProgressEvent) {
+	ev.Timestamp = time.Now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	line, err := json.Marshal(ev)
+	if err != nil {
+		// Progress events are best-effort; never let a marshal failure
+		// take down a run.
+		fmt.Fprintf(p.w, `{"type":"failed","error":%q}`+"\n", err.Error())
+		return
+	}
+	p.w.Write(append(line, '\n'))
+}
+
+// prefixWriter prepends a fixed prefix (e.g. "[run-0003] ") to every line
+// written through it before forwarding to the underlying writer, so
+// interleaved output from concurrent cargo processes stays attributable.
+type prefixWriter struct {
+	mu     *sync.Mutex
+	dst    io.Writer
+	prefix []byte
+	buf    bytes.Buffer
+}
+
+// newPrefixWriter builds a prefixWriter that serializes writes to dst
+// through mu, since multiple runs may share one stdout/stderr.
+func newPrefixWriter(dst io.Writer, mu *sync.Mutex, prefix string) *prefixWriter {
+	return &prefixWriter{dst: dst, mu: mu, prefix: []byte(prefix)}
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for {
+		line, err := w.buf.ReadBytes('\n')
+		if err != nil {
+			// No newline yet; put the partial line back and wait for more.
+			w.buf.Write(line)
+			break
+		}
+		w.dst.Write(w.prefix)
+		w.dst.Write(line)
+	}
+
+	return len(p), nil
+}
+
+// Flush writes out any buffered partial line, terminating it with a
+// newline. Call once the underlying command has exited.
+func (w *prefixWriter) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.buf.Len() == 0 {
+		return
+	}
+	w.dst.Write(w.prefix)
+	w.dst.Write(w.buf.Bytes())
+	w.dst.Write([]byte("\n"))
+	w.buf.Reset()
+}
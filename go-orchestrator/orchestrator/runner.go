@@ -0,0 +1,97 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+)
+
+// RunResult captures everything about a single completed run that the
+// manifest needs.
+type RunResult struct {
+	RunDir    string
+	StartedAt time.Time
+	EndedAt   time.Time
+	ExitCode  int
+}
+
+// Runner executes one RunConfig against the Rust core and returns where its
+// outputs landed. Implementations may run locally (cargo run), over SSH, in
+// a container, or via a SLURM job; the sweep driver only depends on this
+// interface. Run must respect ctx cancellation by tearing down any child
+// process it started.
+type Runner interface {
+	Run(ctx context.Context, cfg RunConfig, runDir string) (RunResult, error)
+}
+
+// LocalRunner invokes `cargo run` against a local rust-core checkout,
+// passing the resolved parameters as environment variables.
+type LocalRunner struct {
+	RustDir string
+	// CargoPath is the cargo binary to invoke, normally the one resolved
+	// by toolchain.Find(). Defaults to "cargo" (a bare PATH lookup) when
+	// left empty, so existing callers that don't care about toolchain
+	// discovery keep working.
+	CargoPath string
+	Stdout    io.Writer
+	Stderr    io.Writer
+}
+
+// NewLocalRunner builds a LocalRunner rooted at rustDir, streaming the
+// child's output to stdout/stderr. Set CargoPath on the result to use a
+// cargo binary resolved by toolchain.Find() instead of a bare PATH lookup.
+func NewLocalRunner(rustDir string) *LocalRunner {
+	return &LocalRunner{RustDir: rustDir, CargoPath: "cargo", Stdout: os.Stdout, Stderr: os.Stderr}
+}
+
+// Run executes the configuration in an isolated runDir so concurrent runs
+// never clobber each other's data/raw/*.csv. If ctx is cancelled while
+// cargo is running, the child process is killed.
+func (r *LocalRunner) Run(ctx context.Context, cfg RunConfig, runDir string) (RunResult, error) {
+	if err := os.MkdirAll(runDir, 0o755); err != nil {
+		return RunResult{}, fmt.Errorf("creating run dir %s: %w", runDir, err)
+	}
+
+	cargoPath := r.CargoPath
+	if cargoPath == "" {
+		cargoPath = "cargo"
+	}
+	cmd := exec.CommandContext(ctx, cargoPath, "run")
+	cmd.Dir = r.RustDir
+	cmd.Env = append(os.Environ(), cfg.Env()...)
+	cmd.Env = append(cmd.Env, fmt.Sprintf("SIM_OUTPUT_DIR=%s", runDir))
+	cmd.Stdout = r.Stdout
+	cmd.Stderr = r.Stderr
+	cmd.Cancel = func() error { return cmd.Process.Signal(os.Interrupt) }
+	cmd.WaitDelay = 5 * time.Second
+
+	started := time.Now()
+	runErr := cmd.Run()
+	ended := time.Now()
+
+	exitCode := 0
+	if runErr != nil {
+		if exitErr, ok := runErr.(*exec.ExitError); ok {
+			exitCode = exitErr.ExitCode()
+		} else {
+			return RunResult{}, fmt.Errorf("running cargo for %s: %w", cfg.RunID, runErr)
+		}
+	}
+
+	return RunResult{
+		RunDir:    runDir,
+		StartedAt: started,
+		EndedAt:   ended,
+		ExitCode:  exitCode,
+	}, nil
+}
+
+// RunDir computes the per-run output directory for a config under dataDir
+// (conventionally <projectRoot>/data/raw).
+func RunDir(dataDir string, cfg RunConfig) string {
+	return filepath.Join(dataDir, cfg.RunID)
+}
@@ -0,0 +1,66 @@
+// Package orchestrator turns a declarative experiment spec into a set of
+// reproducible simulation runs against the Rust neuromorphic core.
+package orchestrator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Spec describes one experiment: a base simulation configuration plus the
+// parameter ranges to sweep over. Fields ending in "s" (e.g. NeuronCounts)
+// hold the sweep values; the cartesian product of all such fields produces
+// the individual RunConfigs.
+type Spec struct {
+	Name string `yaml:"name" toml:"name"`
+
+	NeuronCounts   []int     `yaml:"neuron_counts" toml:"neuron_counts"`
+	LearningRates  []float64 `yaml:"learning_rates" toml:"learning_rates"`
+	SimDurationsMs []int     `yaml:"sim_durations_ms" toml:"sim_durations_ms"`
+	Seeds          []int64   `yaml:"seeds" toml:"seeds"`
+
+	STDP STDPSpec `yaml:"stdp" toml:"stdp"`
+}
+
+// STDPSpec holds the spike-timing-dependent plasticity parameters. Each
+// field is swept the same as the top-level Spec fields: leave it empty to
+// hold that parameter at its default instead of expanding it.
+type STDPSpec struct {
+	APlus      []float64 `yaml:"a_plus" toml:"a_plus"`
+	AMinus     []float64 `yaml:"a_minus" toml:"a_minus"`
+	TauPlusMs  []float64 `yaml:"tau_plus_ms" toml:"tau_plus_ms"`
+	TauMinusMs []float64 `yaml:"tau_minus_ms" toml:"tau_minus_ms"`
+}
+
+// LoadSpec reads an experiment spec from path, dispatching on file
+// extension (.yaml/.yml or .toml).
+func LoadSpec(path string) (*Spec, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading spec %s: %w", path, err)
+	}
+
+	var spec Spec
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(raw, &spec); err != nil {
+			return nil, fmt.Errorf("parsing yaml spec %s: %w", path, err)
+		}
+	case ".toml":
+		if err := toml.Unmarshal(raw, &spec); err != nil {
+			return nil, fmt.Errorf("parsing toml spec %s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported spec extension %q (want .yaml, .yml, or .toml)", ext)
+	}
+
+	if spec.Name == "" {
+		return nil, fmt.Errorf("spec %s: name is required", path)
+	}
+	return &spec, nil
+}
@@ -0,0 +1,104 @@
+package orchestrator
+
+import "fmt"
+
+// RunConfig is one fully-resolved point in the parameter grid: a single
+// combination of neuron count, learning rate, sim duration, seed, and STDP
+// parameters, ready to hand to a Runner.
+type RunConfig struct {
+	RunID string `json:"run_id"`
+
+	NeuronCount  int     `json:"neuron_count"`
+	LearningRate float64 `json:"learning_rate"`
+	SimDuration  int     `json:"sim_duration_ms"`
+	Seed         int64   `json:"seed"`
+
+	APlus      float64 `json:"a_plus"`
+	AMinus     float64 `json:"a_minus"`
+	TauPlusMs  float64 `json:"tau_plus_ms"`
+	TauMinusMs float64 `json:"tau_minus_ms"`
+}
+
+// Env returns the run config as CARGO/rust-core environment variables, the
+// contract the Rust core reads its parameters from.
+func (c RunConfig) Env() []string {
+	return []string{
+		fmt.Sprintf("SIM_NEURON_COUNT=%d", c.NeuronCount),
+		fmt.Sprintf("SIM_LEARNING_RATE=%g", c.LearningRate),
+		fmt.Sprintf("SIM_DURATION_MS=%d", c.SimDuration),
+		fmt.Sprintf("SIM_SEED=%d", c.Seed),
+		fmt.Sprintf("SIM_STDP_A_PLUS=%g", c.APlus),
+		fmt.Sprintf("SIM_STDP_A_MINUS=%g", c.AMinus),
+		fmt.Sprintf("SIM_STDP_TAU_PLUS_MS=%g", c.TauPlusMs),
+		fmt.Sprintf("SIM_STDP_TAU_MINUS_MS=%g", c.TauMinusMs),
+	}
+}
+
+// Expand computes the cartesian product of every swept field in the spec,
+// producing one RunConfig per combination. Empty fields default to a single
+// value so an experiment need not sweep every dimension: zero for the
+// top-level fields, and a_plus 0.01, a_minus 0.012, tau_plus_ms 20 and
+// tau_minus_ms 20 for the STDP fields.
+func (s *Spec) Expand() []RunConfig {
+	neuronCounts := orDefaultInt(s.NeuronCounts, 0)
+	learningRates := orDefaultFloat(s.LearningRates, 0)
+	durations := orDefaultInt(s.SimDurationsMs, 0)
+	seeds := orDefaultSeed(s.Seeds, 0)
+	aPlus := orDefaultFloat(s.STDP.APlus, 0.01)
+	aMinus := orDefaultFloat(s.STDP.AMinus, 0.012)
+	tauPlus := orDefaultFloat(s.STDP.TauPlusMs, 20)
+	tauMinus := orDefaultFloat(s.STDP.TauMinusMs, 20)
+
+	var configs []RunConfig
+	idx := 0
+	for _, n := range neuronCounts {
+		for _, lr := range learningRates {
+			for _, dur := range durations {
+				for _, seed := range seeds {
+					for _, ap := range aPlus {
+						for _, am := range aMinus {
+							for _, tp := range tauPlus {
+								for _, tm := range tauMinus {
+									configs = append(configs, RunConfig{
+										RunID:        fmt.Sprintf("run-%04d", idx),
+										NeuronCount:  n,
+										LearningRate: lr,
+										SimDuration:  dur,
+										Seed:         seed,
+										APlus:        ap,
+										AMinus:       am,
+										TauPlusMs:    tp,
+										TauMinusMs:   tm,
+									})
+									idx++
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return configs
+}
+
+func orDefaultInt(vs []int, def int) []int {
+	if len(vs) == 0 {
+		return []int{def}
+	}
+	return vs
+}
+
+func orDefaultFloat(vs []float64, def float64) []float64 {
+	if len(vs) == 0 {
+		return []float64{def}
+	}
+	return vs
+}
+
+func orDefaultSeed(vs []int64, def int64) []int64 {
+	if len(vs) == 0 {
+		return []int64{def}
+	}
+	return vs
+}
@@ -0,0 +1,67 @@
+package orchestrator
+
+import "testing"
+
+func TestExpandCartesianProduct(t *testing.T) {
+	spec := &Spec{
+		Name:           "test",
+		NeuronCounts:   []int{10, 20},
+		LearningRates:  []float64{0.1},
+		SimDurationsMs: []int{100, 200},
+		Seeds:          []int64{1},
+	}
+
+	configs := spec.Expand()
+
+	want := 2 * 1 * 2 * 1
+	if len(configs) != want {
+		t.Fatalf("Expand() produced %d configs, want %d", len(configs), want)
+	}
+
+	seen := make(map[[2]int]bool)
+	for _, c := range configs {
+		seen[[2]int{c.NeuronCount, c.SimDuration}] = true
+		if c.LearningRate != 0.1 || c.Seed != 1 {
+			t.Errorf("config %+v: unexpected non-swept field", c)
+		}
+	}
+	for _, n := range spec.NeuronCounts {
+		for _, d := range spec.SimDurationsMs {
+			if !seen[[2]int{n, d}] {
+				t.Errorf("Expand() missing combination neuron_count=%d sim_duration=%d", n, d)
+			}
+		}
+	}
+}
+
+func TestExpandDefaultsEmptyFields(t *testing.T) {
+	spec := &Spec{Name: "test"}
+	configs := spec.Expand()
+
+	if len(configs) != 1 {
+		t.Fatalf("Expand() with no swept fields produced %d configs, want 1", len(configs))
+	}
+
+	c := configs[0]
+	if c.NeuronCount != 0 || c.LearningRate != 0 || c.SimDuration != 0 || c.Seed != 0 {
+		t.Errorf("unexpected zero-value defaults: %+v", c)
+	}
+	if c.APlus != 0.01 || c.AMinus != 0.012 || c.TauPlusMs != 20 || c.TauMinusMs != 20 {
+		t.Errorf("unexpected STDP defaults: %+v", c)
+	}
+}
+
+func TestExpandSweepsSTDPParams(t *testing.T) {
+	spec := &Spec{
+		Name: "test",
+		STDP: STDPSpec{APlus: []float64{0.01, 0.02}},
+	}
+
+	configs := spec.Expand()
+	if len(configs) != 2 {
+		t.Fatalf("Expand() produced %d configs, want 2", len(configs))
+	}
+	if configs[0].APlus == configs[1].APlus {
+		t.Errorf("expected distinct APlus values across configs, got %v and %v", configs[0].APlus, configs[1].APlus)
+	}
+}
@@ -0,0 +1,118 @@
+// Package simclient talks to the Rust simulation daemon over the gRPC
+// control plane defined in proto/sim.proto, replacing the fire-and-forget
+// `cargo run` + CSV-on-disk contract with a persistent connection that
+// supports step-by-step interactive control.
+package simclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/orchestrator"
+	"github.com/srikarvela/neuromorphic-ai-paradigm/proto/simpb"
+)
+
+// Client wraps a connection to one simulation daemon instance.
+type Client struct {
+	conn *grpc.ClientConn
+	rpc  simpb.SimClient
+}
+
+// Dial connects to a daemon already listening at addr (host:port).
+func Dial(ctx context.Context, addr string) (*Client, error) {
+	conn, err := grpc.DialContext(ctx, addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("dialing sim daemon at %s: %w", addr, err)
+	}
+	return &Client{conn: conn, rpc: simpb.NewSimClient(conn)}, nil
+}
+
+// Close tears down the underlying connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// toSimConfig converts an orchestrator.RunConfig to its wire form.
+func toSimConfig(cfg orchestrator.RunConfig) *simpb.SimConfig {
+	return &simpb.SimConfig{
+		RunId:          cfg.RunID,
+		NeuronCount:    int32(cfg.NeuronCount),
+		LearningRate:   cfg.LearningRate,
+		SimDurationMs:  int32(cfg.SimDuration),
+		Seed:           cfg.Seed,
+		StdpAPlus:      cfg.APlus,
+		StdpAMinus:     cfg.AMinus,
+		StdpTauPlusMs:  cfg.TauPlusMs,
+		StdpTauMinusMs: cfg.TauMinusMs,
+	}
+}
+
+// Configure (re)initializes the daemon with cfg, required before Step.
+func (c *Client) Configure(ctx context.Context, cfg orchestrator.RunConfig) error {
+	resp, err := c.rpc.Configure(ctx, toSimConfig(cfg))
+	if err != nil {
+		return fmt.Errorf("configuring run %s: %w", cfg.RunID, err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("daemon rejected config for %s: %s", cfg.RunID, resp.Error)
+	}
+	return nil
+}
+
+// Step advances runID by ticks simulated steps, optionally injecting
+// stimulus current keyed by neuron ID.
+func (c *Client) Step(ctx context.Context, runID string, ticks int, inject map[int32]float64) (*simpb.StepResponse, error) {
+	resp, err := c.rpc.Step(ctx, &simpb.StepRequest{
+		RunId:         runID,
+		Ticks:         int32(ticks),
+		InjectCurrent: inject,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("stepping run %s: %w", runID, err)
+	}
+	return resp, nil
+}
+
+// Spikes streams spike events for cfg until ctx is cancelled or the daemon
+// closes the stream.
+func (c *Client) Spikes(ctx context.Context, cfg orchestrator.RunConfig) (simpb.Sim_SpikesClient, error) {
+	stream, err := c.rpc.Spikes(ctx, toSimConfig(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("opening spike stream for %s: %w", cfg.RunID, err)
+	}
+	return stream, nil
+}
+
+// WeightSnapshots streams weight updates for cfg until ctx is cancelled or
+// the daemon closes the stream.
+func (c *Client) WeightSnapshots(ctx context.Context, cfg orchestrator.RunConfig) (simpb.Sim_WeightSnapshotsClient, error) {
+	stream, err := c.rpc.WeightSnapshots(ctx, toSimConfig(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("opening weight stream for %s: %w", cfg.RunID, err)
+	}
+	return stream, nil
+}
+
+// DialWithRetry dials addr, retrying with backoff until ctx is cancelled —
+// useful right after spawning the daemon process, before its listener is
+// guaranteed to be up.
+func DialWithRetry(ctx context.Context, addr string, backoff time.Duration) (*Client, error) {
+	for {
+		client, err := Dial(ctx, addr)
+		if err == nil {
+			return client, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("dialing sim daemon at %s: %w", addr, ctx.Err())
+		case <-time.After(backoff):
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package simclient
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/orchestrator"
+)
+
+// DaemonRunner implements orchestrator.Runner against a persistent Rust
+// simulation daemon instead of spawning `cargo run` per trial. It starts
+// the daemon once, in NewDaemonRunner, and reuses it across every run,
+// avoiding cargo rebuild cost between trials. It does not attach to a
+// daemon that is already running: NewDaemonRunner always spawns one.
+type DaemonRunner struct {
+	Addr string
+
+	proc *exec.Cmd
+	conn *Client
+}
+
+// NewDaemonRunner spawns the Rust daemon binary from rustDir, listening on
+// addr, and waits for it to accept connections.
+func NewDaemonRunner(ctx context.Context, rustDir, addr string) (*DaemonRunner, error) {
+	cmd := exec.CommandContext(ctx, "cargo", "run", "--release", "--bin", "simd", "--", "--listen", addr)
+	cmd.Dir = rustDir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("starting sim daemon: %w", err)
+	}
+
+	conn, err := DialWithRetry(ctx, addr, 200*time.Millisecond)
+	if err != nil {
+		_ = cmd.Process.Kill()
+		return nil, err
+	}
+
+	return &DaemonRunner{Addr: addr, proc: cmd, conn: conn}, nil
+}
+
+// Close disconnects and, if this runner started the daemon itself, tears
+// it down.
+func (r *DaemonRunner) Close() error {
+	if err := r.conn.Close(); err != nil {
+		return err
+	}
+	if r.proc == nil {
+		return nil
+	}
+	return r.proc.Process.Kill()
+}
+
+// Run configures the daemon with cfg and steps it to completion, streaming
+// spikes and weight updates into runDir as they arrive. It is the
+// gRPC-backed equivalent of orchestrator.LocalRunner.Run.
+func (r *DaemonRunner) Run(ctx context.Context, cfg orchestrator.RunConfig, runDir string) (orchestrator.RunResult, error) {
+	return RunTrial(ctx, r.conn, cfg, runDir)
+}
@@ -0,0 +1,181 @@
+package simclient
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/srikarvela/neuromorphic-ai-paradigm/go-orchestrator/orchestrator"
+)
+
+// RunTrial drives one trial to completion against a connected Client: it
+// configures the daemon with cfg, then streams spikes and weight updates
+// into spikes.csv/weights.csv under runDir while stepping the simulation,
+// the gRPC-backed equivalent of
+// orchestrator.LocalRunner.Run's CSV-on-disk output. Both DaemonRunner and
+// cluster.Dispatcher drive trials through this so the stepping and
+// result-pulling logic lives in one place.
+func RunTrial(ctx context.Context, client *Client, cfg orchestrator.RunConfig, runDir string) (orchestrator.RunResult, error) {
+	if err := os.MkdirAll(runDir, 0o755); err != nil {
+		return orchestrator.RunResult{}, fmt.Errorf("creating run dir %s: %w", runDir, err)
+	}
+
+	started := time.Now()
+
+	if err := client.Configure(ctx, cfg); err != nil {
+		return orchestrator.RunResult{}, err
+	}
+
+	streamCtx, cancelStreams := context.WithCancel(ctx)
+	defer cancelStreams()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		errs <- streamSpikes(streamCtx, client, cfg, runDir)
+	}()
+	go func() {
+		defer wg.Done()
+		errs <- streamWeights(streamCtx, client, cfg, runDir)
+	}()
+
+	stepErr := stepToCompletion(ctx, client, cfg)
+	cancelStreams()
+	wg.Wait()
+	close(errs)
+
+	if stepErr != nil {
+		return orchestrator.RunResult{}, stepErr
+	}
+	for err := range errs {
+		if err != nil {
+			return orchestrator.RunResult{}, err
+		}
+	}
+
+	return orchestrator.RunResult{
+		RunDir:    runDir,
+		StartedAt: started,
+		EndedAt:   time.Now(),
+		ExitCode:  0,
+	}, nil
+}
+
+// stepToCompletion steps a configured run 100 ticks at a time until
+// cfg.SimDuration ticks have been stepped or the daemon reports Done,
+// whichever comes first.
+func stepToCompletion(ctx context.Context, client *Client, cfg orchestrator.RunConfig) error {
+	const ticksPerStep = 100
+	remaining := cfg.SimDuration
+	for remaining > 0 {
+		step := ticksPerStep
+		if remaining < step {
+			step = remaining
+		}
+		resp, err := client.Step(ctx, cfg.RunID, step, nil)
+		if err != nil {
+			return err
+		}
+		if resp.Error != "" {
+			return fmt.Errorf("run %s: daemon error: %s", cfg.RunID, resp.Error)
+		}
+		remaining -= step
+		if resp.Done {
+			break
+		}
+	}
+	return nil
+}
+
+// streamSpikes drains the Spikes RPC into spikes.csv until ctx is
+// cancelled (the step loop finished) or the daemon closes the stream.
+func streamSpikes(ctx context.Context, client *Client, cfg orchestrator.RunConfig, runDir string) error {
+	stream, err := client.Spikes(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("opening spike stream for %s: %w", cfg.RunID, err)
+	}
+
+	f, err := os.Create(filepath.Join(runDir, "spikes.csv"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if err := w.Write([]string{"neuron_id", "tick", "potential"}); err != nil {
+		return err
+	}
+
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			w.Flush()
+			return ignoreStreamClosed(err)
+		}
+		if err := w.Write([]string{
+			strconv.Itoa(int(ev.NeuronId)),
+			strconv.FormatInt(ev.Tick, 10),
+			strconv.FormatFloat(ev.Potential, 'g', -1, 64),
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// streamWeights drains the WeightSnapshots RPC into weights.csv the same
+// way streamSpikes does for spikes.csv.
+func streamWeights(ctx context.Context, client *Client, cfg orchestrator.RunConfig, runDir string) error {
+	stream, err := client.WeightSnapshots(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("opening weight stream for %s: %w", cfg.RunID, err)
+	}
+
+	f, err := os.Create(filepath.Join(runDir, "weights.csv"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if err := w.Write([]string{"pre_neuron_id", "post_neuron_id", "weight", "tick"}); err != nil {
+		return err
+	}
+
+	for {
+		up, err := stream.Recv()
+		if err != nil {
+			w.Flush()
+			return ignoreStreamClosed(err)
+		}
+		if err := w.Write([]string{
+			strconv.Itoa(int(up.PreNeuronId)),
+			strconv.Itoa(int(up.PostNeuronId)),
+			strconv.FormatFloat(up.Weight, 'g', -1, 64),
+			strconv.FormatInt(up.Tick, 10),
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// ignoreStreamClosed treats the expected ways a server-streaming RPC ends
+// (the server closing it, or the step loop finishing and cancelling ctx) as
+// success, surfacing only genuine transport errors.
+func ignoreStreamClosed(err error) error {
+	if err == io.EOF || status.Code(err) == codes.Canceled {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,134 @@
+// Package toolchain locates and validates the Rust toolchain the
+// orchestrator shells out to, instead of assuming `cargo` is on PATH and
+// rust-core lives at a fixed relative path.
+package toolchain
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MinCargoVersion is the oldest cargo release the orchestrator is known to
+// work with (it relies on `cargo run --bin`, stabilized well before this).
+var MinCargoVersion = Version{Major: 1, Minor: 60, Patch: 0}
+
+// Sentinel errors so callers can distinguish failure modes instead of
+// string-matching error text.
+var (
+	ErrCargoNotFound   = errors.New("toolchain: cargo not found")
+	ErrVersionTooOld   = errors.New("toolchain: cargo version too old")
+	ErrRustCoreMissing = errors.New("toolchain: rust-core checkout missing or has no Cargo.toml")
+)
+
+// Version is a parsed semantic version, the subset cargo --version reports.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Less reports whether v is older than other.
+func (v Version) Less(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+	return v.Patch < other.Patch
+}
+
+// Toolchain describes where the Rust toolchain was found and its reported
+// version.
+type Toolchain struct {
+	CargoPath string
+	RustcPath string
+	Version   Version
+}
+
+var versionRE = regexp.MustCompile(`(\d+)\.(\d+)\.(\d+)`)
+
+// Find locates cargo and rustc via exec.LookPath, honoring the CARGO and
+// CARGO_HOME environment variables the same way `cargo` itself does, and
+// verifies the discovered cargo meets MinCargoVersion.
+func Find() (*Toolchain, error) {
+	cargoPath, err := lookCargo()
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := cargoVersion(cargoPath)
+	if err != nil {
+		return nil, fmt.Errorf("toolchain: parsing `%s --version`: %w", cargoPath, err)
+	}
+	if version.Less(MinCargoVersion) {
+		return nil, fmt.Errorf("%w: found %s at %s, need >= %s", ErrVersionTooOld, version, cargoPath, MinCargoVersion)
+	}
+
+	// rustc is optional for our purposes (cargo invokes it internally) but
+	// report its path when present for diagnostics.
+	rustcPath, _ := exec.LookPath("rustc")
+
+	return &Toolchain{CargoPath: cargoPath, RustcPath: rustcPath, Version: version}, nil
+}
+
+// lookCargo honors $CARGO first (the variable cargo itself sets when it
+// re-execs a subcommand), then $CARGO_HOME/bin/cargo (where rustup actually
+// installs the cargo shim), then falls back to PATH. $RUSTUP_HOME only
+// holds toolchains/downloads, not the cargo binary itself, so it is not
+// consulted here.
+func lookCargo() (string, error) {
+	if p := os.Getenv("CARGO"); p != "" {
+		if _, err := os.Stat(p); err == nil {
+			return p, nil
+		}
+	}
+
+	if home := os.Getenv("CARGO_HOME"); home != "" {
+		candidate := filepath.Join(home, "bin", "cargo")
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+
+	path, err := exec.LookPath("cargo")
+	if err != nil {
+		return "", fmt.Errorf("%w: %w (install rustup or set $CARGO)", ErrCargoNotFound, err)
+	}
+	return path, nil
+}
+
+func cargoVersion(cargoPath string) (Version, error) {
+	out, err := exec.Command(cargoPath, "--version").Output()
+	if err != nil {
+		return Version{}, err
+	}
+
+	m := versionRE.FindStringSubmatch(string(out))
+	if m == nil {
+		return Version{}, fmt.Errorf("unrecognized version output: %q", strings.TrimSpace(string(out)))
+	}
+
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	patch, _ := strconv.Atoi(m[3])
+	return Version{Major: major, Minor: minor, Patch: patch}, nil
+}
+
+// ValidateRustCore checks that rustDir exists and contains a Cargo.toml,
+// returning ErrRustCoreMissing with an actionable message otherwise.
+func ValidateRustCore(rustDir string) error {
+	manifest := filepath.Join(rustDir, "Cargo.toml")
+	if _, err := os.Stat(manifest); err != nil {
+		return fmt.Errorf("%w: expected %s (pass -rust-dir to point elsewhere)", ErrRustCoreMissing, manifest)
+	}
+	return nil
+}
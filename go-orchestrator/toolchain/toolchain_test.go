@@ -0,0 +1,61 @@
+package toolchain
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestVersionLess(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b Version
+		want bool
+	}{
+		{"major less", Version{1, 60, 0}, Version{2, 0, 0}, true},
+		{"major greater", Version{2, 0, 0}, Version{1, 60, 0}, false},
+		{"minor less", Version{1, 59, 9}, Version{1, 60, 0}, true},
+		{"minor greater", Version{1, 60, 0}, Version{1, 59, 9}, false},
+		{"patch less", Version{1, 60, 0}, Version{1, 60, 1}, true},
+		{"equal", Version{1, 60, 0}, Version{1, 60, 0}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.a.Less(c.b); got != c.want {
+				t.Errorf("%s.Less(%s) = %v, want %v", c.a, c.b, got, c.want)
+			}
+		})
+	}
+}
+
+func TestCargoVersionParsing(t *testing.T) {
+	cases := []struct {
+		output  string
+		want    Version
+		wantErr bool
+	}{
+		{"cargo 1.75.0 (1d8b05cdd 2023-11-20)\n", Version{1, 75, 0}, false},
+		{"cargo 1.60.0-nightly (2022-01-15)\n", Version{1, 60, 0}, false},
+		{"not a version string\n", Version{}, true},
+	}
+
+	for _, c := range cases {
+		m := versionRE.FindStringSubmatch(c.output)
+		if c.wantErr {
+			if m != nil {
+				t.Errorf("FindStringSubmatch(%q) = %v, want no match", c.output, m)
+			}
+			continue
+		}
+		if m == nil {
+			t.Fatalf("FindStringSubmatch(%q) = nil, want a match", c.output)
+		}
+		major, _ := strconv.Atoi(m[1])
+		minor, _ := strconv.Atoi(m[2])
+		patch, _ := strconv.Atoi(m[3])
+		got := Version{Major: major, Minor: minor, Patch: patch}
+		if got != c.want {
+			t.Errorf("parsed %q as %s, want %s", c.output, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+// Package proto holds the protobuf/gRPC contract between the Go
+// orchestrator and the Rust simulation daemon. Generated Go bindings land
+// in ./simpb and are vendored in-repo; run `go generate` after editing
+// sim.proto and commit the regenerated files alongside it.
+package proto
+
+//go:generate protoc --go_out=simpb --go_opt=paths=source_relative --go-grpc_out=simpb --go-grpc_opt=paths=source_relative sim.proto